@@ -0,0 +1,74 @@
+package resperr
+
+import "fmt"
+
+// Coder is an error with an associated machine-readable error code.
+// Code may return "" to indicate that
+// the code should be taken from another error in the chain.
+type Coder interface {
+	error
+	Code() string
+}
+
+// ErrorCode returns the machine-readable error code associated with an error.
+// If no code is found, it returns "".
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	for c := range allAs[Coder](err) {
+		if code := c.Code(); code != "" {
+			return code
+		}
+	}
+	return ""
+}
+
+// CodeDefault is the default status and user message registered for a code
+// in a CodeRegistry.
+type CodeDefault struct {
+	Status  int
+	Message string
+}
+
+// CodeRegistry maps machine-readable error codes to a default status and
+// user message, so NewCode can fill in a status and message for a known code
+// automatically.
+type CodeRegistry map[string]CodeDefault
+
+// buildCode fills in an E's code, status, and message for code, using reg's
+// registered default if any, leaving stack capture to the caller.
+func buildCode(reg CodeRegistry, code string, format string, v ...any) E {
+	e := E{
+		C: code,
+		E: fmt.Errorf(format, v...),
+	}
+	if d, ok := reg[code]; ok {
+		e.S = d.Status
+		e.M = d.Message
+	}
+	return e
+}
+
+// NewCode creates an error with code set, filling in the status and user
+// message registered for code in reg, if any, and calling fmt.Errorf with
+// format and v for the cause.
+func (reg CodeRegistry) NewCode(code string, format string, v ...any) error {
+	e := buildCode(reg, code, format, v...)
+	if CaptureStack {
+		e.pc = callers()
+	}
+	return e
+}
+
+// Codes is the default CodeRegistry used by NewCode.
+var Codes = make(CodeRegistry)
+
+// NewCode is a convenience function for calling Codes.NewCode.
+func NewCode(code string, format string, v ...any) error {
+	e := buildCode(Codes, code, format, v...)
+	if CaptureStack {
+		e.pc = callers()
+	}
+	return e
+}
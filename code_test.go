@@ -0,0 +1,31 @@
+package resperr_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestErrorCode(t *testing.T) {
+	err := resperr.E{C: "auth.forbidden", S: http.StatusForbidden}
+	be.Equal(t, "auth.forbidden", resperr.ErrorCode(err))
+}
+
+func TestCodeRegistry(t *testing.T) {
+	reg := resperr.CodeRegistry{
+		"auth.forbidden": {Status: http.StatusForbidden, Message: "Forbidden"},
+	}
+	err := reg.NewCode("auth.forbidden", "user %q denied", "alice")
+	be.Equal(t, http.StatusForbidden, resperr.StatusCode(err))
+	be.Equal(t, "Forbidden", resperr.UserMessage(err))
+	be.Equal(t, "auth.forbidden", resperr.ErrorCode(err))
+	be.In(t, `user "alice" denied`, err.Error())
+}
+
+func TestNewCode_unregistered(t *testing.T) {
+	err := resperr.NewCode("widget.missing", "no widget")
+	be.Equal(t, "widget.missing", resperr.ErrorCode(err))
+	be.Equal(t, http.StatusInternalServerError, resperr.StatusCode(err))
+}
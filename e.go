@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // E is a simple struct for building response errors.
@@ -12,6 +13,14 @@ type E struct {
 	S int    // StatusCode
 	M string // UserMessage
 	E error  // Cause
+	C string // Code
+
+	Type     string // RFC 7807 problem type URI
+	Instance string // RFC 7807 instance URI
+
+	RetryAfter *time.Duration // how long a client should wait before retrying; nil means unset, a pointer to zero means "retry immediately"
+
+	pc []uintptr // call stack captured by New, Wrap, or NewCode
 }
 
 func (e E) Error() string {
@@ -64,3 +73,43 @@ func (e E) UserMessage() string {
 	}
 	return ""
 }
+
+func (e E) Code() string {
+	if e.C != "" {
+		return e.C
+	}
+	if e.E != nil {
+		return ErrorCode(e.E)
+	}
+	return ""
+}
+
+func (e E) GetRetryAfter() (time.Duration, bool) {
+	if e.RetryAfter != nil {
+		return *e.RetryAfter, true
+	}
+	if e.E != nil {
+		return RetryAfter(e.E)
+	}
+	return 0, false
+}
+
+func (e E) ErrorType() string {
+	if e.Type != "" {
+		return e.Type
+	}
+	if e.E != nil {
+		return ErrorType(e.E)
+	}
+	return ""
+}
+
+func (e E) ErrorInstance() string {
+	if e.Instance != "" {
+		return e.Instance
+	}
+	if e.E != nil {
+		return ErrorInstance(e.E)
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+// Package grpcerr bridges resperr errors with gRPC and Connect-Go status
+// codes, so services that front both HTTP and gRPC can share one error type.
+//
+// The mapping is kept in its own module so that google.golang.org/grpc stays
+// out of the dependency graph of the root resperr package.
+package grpcerr
+
+import (
+	"github.com/earthboundkid/resperr/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatus is the canonical HTTP status for each gRPC status code, per
+// https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto.
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 200,
+	codes.InvalidArgument:    400,
+	codes.FailedPrecondition: 400,
+	codes.OutOfRange:         400,
+	codes.Unauthenticated:    401,
+	codes.PermissionDenied:   403,
+	codes.NotFound:           404,
+	codes.AlreadyExists:      409,
+	codes.Aborted:            409,
+	codes.ResourceExhausted:  429,
+	codes.Canceled:           499,
+	codes.Unknown:            500,
+	codes.Internal:           500,
+	codes.DataLoss:           500,
+	codes.Unimplemented:      501,
+	codes.Unavailable:        503,
+	codes.DeadlineExceeded:   504,
+}
+
+// grpcCodePreference lists, for HTTP statuses that more than one gRPC code
+// maps to, the preferred code to use when going the other direction.
+// Earlier entries win on collision, e.g. InvalidArgument over
+// FailedPrecondition/OutOfRange for 400, and Internal over Unknown/DataLoss
+// for 500.
+var grpcCodePreference = []codes.Code{
+	codes.OK,
+	codes.InvalidArgument,
+	codes.Unauthenticated,
+	codes.PermissionDenied,
+	codes.NotFound,
+	codes.AlreadyExists,
+	codes.ResourceExhausted,
+	codes.Canceled,
+	codes.Internal,
+	codes.Unimplemented,
+	codes.Unavailable,
+	codes.DeadlineExceeded,
+	codes.FailedPrecondition,
+	codes.OutOfRange,
+	codes.Aborted,
+	codes.Unknown,
+	codes.DataLoss,
+}
+
+// grpcCode is the inverse of httpStatus, built at init time.
+var grpcCode = func() map[int]codes.Code {
+	m := make(map[int]codes.Code, len(httpStatus))
+	for _, code := range grpcCodePreference {
+		status, ok := httpStatus[code]
+		if !ok {
+			continue
+		}
+		if _, exists := m[status]; !exists {
+			m[status] = code
+		}
+	}
+	return m
+}()
+
+// GRPCCode returns the gRPC status code that corresponds to err's
+// resperr.StatusCode.
+func GRPCCode(err error) codes.Code {
+	code, ok := grpcCode[resperr.StatusCode(err)]
+	if !ok {
+		return codes.Unknown
+	}
+	return code
+}
+
+// FromGRPCError converts a gRPC status error into a resperr error, preserving
+// err as the cause and mapping its code to an HTTP status. Only errors that
+// status.FromError recognizes are converted; any other error is returned
+// unchanged.
+func FromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return resperr.E{
+		S: httpStatus[st.Code()],
+		M: st.Message(),
+		E: err,
+	}
+}
+
+// GRPCStatus returns the *status.Status that corresponds to err. Unlike
+// WithStatus, returning err itself from a handler does not get picked up by
+// gRPC's status.FromError, since resperr.E can't implement a GRPCStatus
+// method for the root resperr package to avoid importing
+// google.golang.org/grpc.
+func GRPCStatus(err error) *status.Status {
+	return status.New(GRPCCode(err), resperr.UserMessage(err))
+}
+
+// statusError wraps an error so it implements the GRPCStatus() *status.Status
+// method that gRPC's status.FromError, and therefore its interceptor chain,
+// looks for on error values.
+type statusError struct {
+	error
+}
+
+func (se statusError) GRPCStatus() *status.Status {
+	return GRPCStatus(se.error)
+}
+
+func (se statusError) Unwrap() error {
+	return se.error
+}
+
+// WithStatus wraps err so that gRPC interceptors pick up its status
+// automatically: the returned error implements GRPCStatus() *status.Status,
+// the method gRPC's status.FromError looks for.
+func WithStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	return statusError{err}
+}
@@ -0,0 +1,48 @@
+package grpcerr_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/grpcerr"
+	"github.com/earthboundkid/resperr/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCCode(t *testing.T) {
+	err := resperr.New(http.StatusNotFound, "not found")
+	be.Equal(t, codes.NotFound, grpcerr.GRPCCode(err))
+}
+
+func TestGRPCCode_internal(t *testing.T) {
+	err := resperr.New(http.StatusInternalServerError, "boom")
+	be.Equal(t, codes.Internal, grpcerr.GRPCCode(err))
+}
+
+func TestFromGRPCError(t *testing.T) {
+	grpcErr := status.Error(codes.PermissionDenied, "no access")
+	err := grpcerr.FromGRPCError(grpcErr)
+	be.Equal(t, http.StatusForbidden, resperr.StatusCode(err))
+	be.Equal(t, "no access", resperr.UserMessage(err))
+	be.True(t, errors.Is(err, grpcErr))
+}
+
+func TestGRPCStatus(t *testing.T) {
+	err := resperr.New(http.StatusTooManyRequests, "slow down")
+	st := grpcerr.GRPCStatus(err)
+	be.Equal(t, codes.ResourceExhausted, st.Code())
+}
+
+func TestWithStatus(t *testing.T) {
+	err := resperr.E{S: http.StatusTooManyRequests, M: "slow down"}
+	wrapped := grpcerr.WithStatus(err)
+
+	st, ok := status.FromError(wrapped)
+	be.True(t, ok)
+	be.Equal(t, codes.ResourceExhausted, st.Code())
+	be.Equal(t, "slow down", st.Message())
+	be.Equal(t, http.StatusTooManyRequests, resperr.StatusCode(wrapped))
+}
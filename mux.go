@@ -0,0 +1,35 @@
+package resperr
+
+import "net/http"
+
+// ErrorResponder writes err to w as an HTTP response.
+type ErrorResponder func(w http.ResponseWriter, r *http.Request, err error)
+
+// Mux adapts a Handler to an http.Handler. The zero value is ready to use and
+// behaves like ProblemHandler.
+type Mux struct {
+	// Respond writes a Handler's returned error to the response.
+	// Defaults to WriteProblem.
+	Respond ErrorResponder
+	// Log, if set, is called with a Handler's returned error before Respond.
+	Log func(r *http.Request, err error)
+}
+
+// Wrap adapts next to an http.Handler, logging and writing any error it
+// returns via mux.Log and mux.Respond.
+func (mux Mux) Wrap(next Handler) http.Handler {
+	respond := mux.Respond
+	if respond == nil {
+		respond = WriteProblem
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := next(w, r)
+		if err == nil {
+			return
+		}
+		if mux.Log != nil {
+			mux.Log(r, err)
+		}
+		respond(w, r, err)
+	})
+}
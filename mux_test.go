@@ -0,0 +1,55 @@
+package resperr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestMux(t *testing.T) {
+	var logged error
+	mux := resperr.Mux{
+		Log: func(r *http.Request, err error) { logged = err },
+	}
+	h := mux.Wrap(func(w http.ResponseWriter, r *http.Request) error {
+		return resperr.New(http.StatusForbidden, "nope")
+	})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	h.ServeHTTP(w, r)
+
+	be.Equal(t, http.StatusForbidden, w.Code)
+	be.Nonzero(t, logged)
+}
+
+func TestMux_customRespond(t *testing.T) {
+	mux := resperr.Mux{
+		Respond: func(w http.ResponseWriter, r *http.Request, err error) {
+			w.WriteHeader(resperr.StatusCode(err))
+			w.Write([]byte(resperr.UserMessage(err)))
+		},
+	}
+	h := mux.Wrap(func(w http.ResponseWriter, r *http.Request) error {
+		return resperr.E{S: http.StatusTeapot, M: "no coffee"}
+	})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	h.ServeHTTP(w, r)
+
+	be.Equal(t, http.StatusTeapot, w.Code)
+	be.Equal(t, "no coffee", w.Body.String())
+}
+
+func TestMux_noError(t *testing.T) {
+	h := resperr.Mux{}.Wrap(func(w http.ResponseWriter, r *http.Request) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	h.ServeHTTP(w, r)
+	be.Equal(t, http.StatusOK, w.Code)
+}
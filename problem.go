@@ -0,0 +1,79 @@
+package resperr
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Problem is the application/problem+json (RFC 7807) representation of an error.
+type Problem struct {
+	Type          string         `json:"type,omitempty"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          string         `json:"code,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam is a single field/message entry in a Problem's invalid-params
+// extension, following the RFC 7807 convention for validation errors.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ProblemFor builds the RFC 7807 Problem representation of err.
+func ProblemFor(err error) Problem {
+	code := StatusCode(err)
+	p := Problem{
+		Type:     ErrorType(err),
+		Title:    http.StatusText(code),
+		Status:   code,
+		Detail:   UserMessage(err),
+		Instance: ErrorInstance(err),
+		Code:     ErrorCode(err),
+	}
+	validationErrs := ValidationErrors(err)
+	fields := make([]string, 0, len(validationErrs))
+	for field := range validationErrs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		msgs := validationErrs[field]
+		sort.Strings(msgs)
+		for _, msg := range msgs {
+			p.InvalidParams = append(p.InvalidParams, InvalidParam{field, msg})
+		}
+	}
+	return p
+}
+
+// WriteProblem writes err to w as an application/problem+json (RFC 7807) response.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	p := ProblemFor(err)
+	b, jerr := json.Marshal(p)
+	if jerr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if d, ok := RetryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(d.Seconds())))
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	w.Write(b)
+}
+
+// Handler is an HTTP handler that can fail.
+// Use ProblemHandler to adapt a Handler to an http.Handler.
+type Handler func(http.ResponseWriter, *http.Request) error
+
+// ProblemHandler adapts next to an http.Handler, writing any error it returns
+// with WriteProblem.
+func ProblemHandler(next Handler) http.Handler {
+	return Mux{}.Wrap(next)
+}
@@ -0,0 +1,52 @@
+package resperr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestWriteProblem(t *testing.T) {
+	err := resperr.E{
+		S:        http.StatusNotFound,
+		M:        "item not found",
+		Type:     "https://example.com/probs/not-found",
+		Instance: "/items/123",
+	}
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/items/123", nil)
+	resperr.WriteProblem(w, r, err)
+
+	be.Equal(t, http.StatusNotFound, w.Code)
+	be.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	be.In(t, `"type":"https://example.com/probs/not-found"`, w.Body.String())
+	be.In(t, `"title":"Not Found"`, w.Body.String())
+	be.In(t, `"detail":"item not found"`, w.Body.String())
+	be.In(t, `"instance":"/items/123"`, w.Body.String())
+}
+
+func TestWriteProblem_validation(t *testing.T) {
+	var v resperr.Validator
+	v.AddIf("n", true, "Please enter a number.")
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/items", nil)
+	resperr.WriteProblem(w, r, v.Err())
+
+	be.Equal(t, http.StatusBadRequest, w.Code)
+	be.In(t, `"invalid-params":[{"name":"n","reason":"Please enter a number."}]`, w.Body.String())
+}
+
+func TestProblemHandler(t *testing.T) {
+	h := resperr.ProblemHandler(func(w http.ResponseWriter, r *http.Request) error {
+		return resperr.E{S: http.StatusTeapot, M: "no coffee here"}
+	})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/coffee", nil)
+	h.ServeHTTP(w, r)
+
+	be.Equal(t, http.StatusTeapot, w.Code)
+	be.In(t, `"detail":"no coffee here"`, w.Body.String())
+}
@@ -78,6 +78,50 @@ func UserMessage(err error) string {
 	return http.StatusText(StatusCode(err))
 }
 
+// TypeURI is an error with an associated RFC 7807 problem type URI.
+// ErrorType may return "" to indicate that
+// the type URI should be taken from another error in the chain.
+type TypeURI interface {
+	error
+	ErrorType() string
+}
+
+// ErrorType returns the RFC 7807 problem type URI associated with an error.
+// If no type is found, it returns "".
+func ErrorType(err error) string {
+	if err == nil {
+		return ""
+	}
+	for t := range allAs[TypeURI](err) {
+		if uri := t.ErrorType(); uri != "" {
+			return uri
+		}
+	}
+	return ""
+}
+
+// Instancer is an error with an associated RFC 7807 instance URI.
+// ErrorInstance may return "" to indicate that
+// the instance URI should be taken from another error in the chain.
+type Instancer interface {
+	error
+	ErrorInstance() string
+}
+
+// ErrorInstance returns the RFC 7807 instance URI associated with an error.
+// If no instance is found, it returns "".
+func ErrorInstance(err error) string {
+	if err == nil {
+		return ""
+	}
+	for inst := range allAs[Instancer](err) {
+		if uri := inst.ErrorInstance(); uri != "" {
+			return uri
+		}
+	}
+	return ""
+}
+
 // NotFound creates an error with a 404 status code and a user message
 // showing the request path that was not found.
 func NotFound(r *http.Request) error {
@@ -89,10 +133,27 @@ func NotFound(r *http.Request) error {
 
 // New is a convenience function for setting a status code and calling fmt.Errorf.
 func New(code int, format string, v ...any) error {
-	return E{
+	e := E{
 		S: code,
 		E: fmt.Errorf(format, v...),
 	}
+	if CaptureStack {
+		e.pc = callers()
+	}
+	return e
+}
+
+// Wrap is a convenience function for setting a status code and cause,
+// adding context to the cause with fmt.Errorf in the style of format and v.
+func Wrap(err error, code int, format string, v ...any) error {
+	e := E{
+		S: code,
+		E: fmt.Errorf(format+": %w", append(v, err)...),
+	}
+	if CaptureStack {
+		e.pc = callers()
+	}
+	return e
 }
 
 // M is a convenience function for calling fmt.Sprintf for a UserMessage.
@@ -0,0 +1,37 @@
+package resperr
+
+import "time"
+
+// RetryAfterer is an error with an associated retry delay, for responses
+// like 429 Too Many Requests or 503 Service Unavailable. GetRetryAfter's
+// second return reports whether a delay was set, since a zero duration is a
+// valid "retry immediately" value.
+type RetryAfterer interface {
+	error
+	GetRetryAfter() (time.Duration, bool)
+}
+
+// RetryAfter returns the retry delay associated with an error, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+	for ra := range allAs[RetryAfterer](err) {
+		if d, ok := ra.GetRetryAfter(); ok {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// WithRetryAfter sets d as err's retry delay, wrapping err in an E if it
+// isn't already one. It can't be an option on New because New's final
+// parameter is already a variadic list of fmt.Errorf arguments.
+func WithRetryAfter(err error, d time.Duration) error {
+	e, ok := err.(E)
+	if !ok {
+		e = E{E: err}
+	}
+	e.RetryAfter = &d
+	return e
+}
@@ -0,0 +1,37 @@
+package resperr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestRetryAfter(t *testing.T) {
+	_, ok := resperr.RetryAfter(resperr.New(http.StatusTooManyRequests, "slow down"))
+	be.False(t, ok)
+
+	err := resperr.WithRetryAfter(resperr.New(http.StatusTooManyRequests, "slow down"), 30*time.Second)
+	d, ok := resperr.RetryAfter(err)
+	be.True(t, ok)
+	be.Equal(t, 30*time.Second, d)
+}
+
+func TestRetryAfter_zero(t *testing.T) {
+	err := resperr.WithRetryAfter(resperr.New(http.StatusTooManyRequests, "slow down"), 0)
+	d, ok := resperr.RetryAfter(err)
+	be.True(t, ok)
+	be.Equal(t, time.Duration(0), d)
+}
+
+func TestWriteProblem_retryAfter(t *testing.T) {
+	err := resperr.WithRetryAfter(resperr.New(http.StatusServiceUnavailable, "down for maintenance"), time.Minute)
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	resperr.WriteProblem(w, r, err)
+
+	be.Equal(t, "60", w.Header().Get("Retry-After"))
+}
@@ -0,0 +1,32 @@
+package resperr
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// SlogAttrs returns status, code, user_message, validation, and stack
+// attributes describing err, suitable for passing to
+// slog.ErrorContext(ctx, "request failed", resperr.SlogAttrs(err)...).
+// The status and user_message attributes are always present; code,
+// validation, and stack are included only when err carries them.
+func SlogAttrs(err error) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.Int("status", StatusCode(err)),
+		slog.String("user_message", UserMessage(err)),
+	}
+	if code := ErrorCode(err); code != "" {
+		attrs = append(attrs, slog.String("code", code))
+	}
+	if v := ValidationErrors(err); len(v) > 0 {
+		attrs = append(attrs, slog.Any("validation", v))
+	}
+	if frames := StackTrace(err); len(frames) > 0 {
+		stack := make([]string, len(frames))
+		for i, fr := range frames {
+			stack[i] = fmt.Sprintf("%s:%d %s", fr.File, fr.Line, fr.Function)
+		}
+		attrs = append(attrs, slog.Any("stack", stack))
+	}
+	return attrs
+}
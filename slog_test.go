@@ -0,0 +1,25 @@
+package resperr_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestSlogAttrs(t *testing.T) {
+	var v resperr.Validator
+	v.AddIf("n", true, "Please enter a number.")
+	err := resperr.E{C: "validation.failed", E: v.Err(), S: http.StatusBadRequest}
+
+	attrs := resperr.SlogAttrs(err)
+	found := map[string]bool{}
+	for _, a := range attrs {
+		found[a.Key] = true
+	}
+	be.True(t, found["status"])
+	be.True(t, found["user_message"])
+	be.True(t, found["code"])
+	be.True(t, found["validation"])
+}
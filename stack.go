@@ -0,0 +1,65 @@
+package resperr
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// CaptureStack controls whether New, Wrap, and NewCode record a call stack on
+// the errors they create. Disable it on hot paths where the allocation from
+// runtime.Callers isn't worth paying for.
+var CaptureStack = true
+
+const maxStackDepth = 32
+
+// callers captures the call stack of the caller of the function that calls
+// callers, skipping callers, the capturing function (New, Wrap, etc.), and
+// runtime.Callers itself.
+func callers() []uintptr {
+	pc := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(3, pc)
+	return pc[:n]
+}
+
+// stackTracer is an error with a captured call stack. It's unexported because
+// only E can capture a stack; see StackTrace for the exported accessor.
+type stackTracer interface {
+	error
+	callStack() []uintptr
+}
+
+func (e E) callStack() []uintptr { return e.pc }
+
+// StackTrace returns the deepest captured call stack found in err's error
+// chain, or nil if none was captured.
+func StackTrace(err error) []runtime.Frame {
+	var frames []runtime.Frame
+	for st := range allAs[stackTracer](err) {
+		pc := st.callStack()
+		if len(pc) == 0 {
+			continue
+		}
+		frames = frames[:0]
+		framesIter := runtime.CallersFrames(pc)
+		for {
+			frame, more := framesIter.Next()
+			frames = append(frames, frame)
+			if !more {
+				break
+			}
+		}
+	}
+	return frames
+}
+
+// Format implements fmt.Formatter so that %+v prints err's message followed
+// by its captured call stack, one "file:line func" entry per line.
+func (e E) Format(f fmt.State, verb rune) {
+	io.WriteString(f, e.Error())
+	if verb == 'v' && f.Flag('+') {
+		for _, fr := range StackTrace(e) {
+			fmt.Fprintf(f, "\n%s:%d %s", fr.File, fr.Line, fr.Function)
+		}
+	}
+}
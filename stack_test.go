@@ -0,0 +1,47 @@
+package resperr_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/carlmjohnson/be"
+	"github.com/earthboundkid/resperr/v2"
+)
+
+func TestStackTrace(t *testing.T) {
+	err := resperr.New(500, "boom")
+	frames := resperr.StackTrace(err)
+	be.Unequal(t, 0, len(frames))
+	be.In(t, "TestStackTrace", frames[0].Function)
+}
+
+func TestStackTrace_newCode(t *testing.T) {
+	err := resperr.NewCode("widget.missing", "no widget")
+	frames := resperr.StackTrace(err)
+	be.Unequal(t, 0, len(frames))
+	be.In(t, "TestStackTrace_newCode", frames[0].Function)
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("dial tcp: connection refused")
+	err := resperr.Wrap(cause, 500, "could not connect to database")
+	be.True(t, errors.Is(err, cause))
+	be.Equal(t, 500, resperr.StatusCode(err))
+	be.In(t, cause.Error(), err.Error())
+}
+
+func TestFormat(t *testing.T) {
+	err := resperr.New(500, "boom")
+	got := fmt.Sprintf("%+v", err)
+	be.In(t, "boom", got)
+	be.True(t, strings.Contains(got, "stack_test.go"))
+}
+
+func TestNoCaptureStack(t *testing.T) {
+	resperr.CaptureStack = false
+	defer func() { resperr.CaptureStack = true }()
+	err := resperr.New(500, "boom")
+	be.Equal(t, 0, len(resperr.StackTrace(err)))
+}